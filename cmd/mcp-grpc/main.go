@@ -0,0 +1,75 @@
+// Command mcp-grpc exposes the same tools/prompts/resources as
+// cmd/mcp-server, but over gRPC (see proto/mcp.proto) instead of stdio or
+// Streamable HTTP.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/syrin-labs/cli/internal/bootstrap"
+	"github.com/syrin-labs/cli/internal/config"
+	"github.com/syrin-labs/cli/internal/grpcserver"
+	"github.com/syrin-labs/cli/internal/weather"
+
+	mcpv1 "github.com/syrin-labs/cli/proto/mcpv1"
+)
+
+const defaultConfigPath = "config.yaml"
+
+func main() {
+	configPath := defaultConfigPath
+	if v := os.Getenv("MCP_CONFIG_PATH"); v != "" {
+		configPath = v
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		log.Fatalf("loading config: %v", err)
+	}
+
+	weatherClient := weather.NewClient(weather.Config{
+		APIKey:   cfg.Weather.APIKey,
+		BaseURL:  cfg.Weather.BaseURL,
+		CacheTTL: cfg.Weather.CacheTTLDuration(),
+	})
+	recommender, err := bootstrap.BuildRecommender(cfg)
+	if err != nil {
+		log.Fatalf("building food recommender: %v", err)
+	}
+	server := bootstrap.BuildServer(cfg, weatherClient, recommender)
+
+	var opts []grpc.ServerOption
+	if cfg.GRPC.TLSEnabled() {
+		cert, err := tls.LoadX509KeyPair(cfg.GRPC.TLSCertFile, cfg.GRPC.TLSKeyFile)
+		if err != nil {
+			log.Fatalf("loading gRPC TLS keypair: %v", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}})))
+	}
+
+	ctx := context.Background()
+	adapter, err := grpcserver.New(ctx, server)
+	if err != nil {
+		log.Fatalf("connecting in-process mcp session: %v", err)
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	mcpv1.RegisterMcpServiceServer(grpcServer, adapter)
+
+	lis, err := net.Listen("tcp", cfg.GRPC.Addr)
+	if err != nil {
+		log.Fatalf("listening on %s: %v", cfg.GRPC.Addr, err)
+	}
+
+	log.Printf("mcp-grpc server running on %s", cfg.GRPC.Addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("server failed: %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+// Command mcp-server runs the weather/food-recommendation MCP server over
+// either stdio or Streamable HTTP, picked by config.Config.Transport so the
+// same binary can be deployed in both modes.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/syrin-labs/cli/internal/bootstrap"
+	"github.com/syrin-labs/cli/internal/config"
+	"github.com/syrin-labs/cli/internal/weather"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const defaultConfigPath = "config.yaml"
+
+func main() {
+	configPath := defaultConfigPath
+	if v := os.Getenv("MCP_CONFIG_PATH"); v != "" {
+		configPath = v
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		log.Fatalf("loading config: %v", err)
+	}
+
+	weatherClient := weather.NewClient(weather.Config{
+		APIKey:   cfg.Weather.APIKey,
+		BaseURL:  cfg.Weather.BaseURL,
+		CacheTTL: cfg.Weather.CacheTTLDuration(),
+	})
+	recommender, err := bootstrap.BuildRecommender(cfg)
+	if err != nil {
+		log.Fatalf("building food recommender: %v", err)
+	}
+	server := bootstrap.BuildServer(cfg, weatherClient, recommender)
+
+	switch cfg.Transport {
+	case "", "stdio":
+		if err := server.Run(context.Background(), &mcp.StdioTransport{}); err != nil {
+			log.Printf("server failed: %v", err)
+		}
+	case "http":
+		runHTTP(cfg, server, weatherClient)
+	default:
+		log.Fatalf("unknown transport %q (want \"stdio\" or \"http\")", cfg.Transport)
+	}
+}
+
+func runHTTP(cfg *config.Config, server *mcp.Server, weatherClient *weather.Client) {
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
+		return server
+	}, nil)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status":"ok","server":%q}`, cfg.Server.Name)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+		if err := weatherClient.Ping(ctx); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintf(w, `{"status":"not ready","reason":%q}`, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status":"ready"}`)
+	})
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle(cfg.HTTP.Endpoint, withLimitsAndCORS(cfg, handler))
+
+	fmt.Printf("MCP server running on http://localhost%s%s\n", cfg.HTTP.Addr, cfg.HTTP.Endpoint)
+	fmt.Printf("Health check: http://localhost%s/health\n", cfg.HTTP.Addr)
+	fmt.Printf("Metrics: http://localhost%s/metrics\n", cfg.HTTP.Addr)
+
+	if cfg.HTTP.TLSEnabled() {
+		if err := http.ListenAndServeTLS(cfg.HTTP.Addr, cfg.HTTP.TLSCertFile, cfg.HTTP.TLSKeyFile, mux); err != nil {
+			log.Fatalf("server failed: %v", err)
+		}
+		return
+	}
+	if err := http.ListenAndServe(cfg.HTTP.Addr, mux); err != nil {
+		log.Fatalf("server failed: %v", err)
+	}
+}
+
+// withLimitsAndCORS enforces cfg.HTTP.MaxRequestBytes and cfg.HTTP.AllowedOrigins
+// around the MCP Streamable HTTP handler.
+func withLimitsAndCORS(cfg *config.Config, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" && originAllowed(cfg.HTTP.AllowedOrigins, origin) {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+		if cfg.HTTP.MaxRequestBytes > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, cfg.HTTP.MaxRequestBytes)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func originAllowed(allowed []string, origin string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		if a == "*" || strings.EqualFold(a, origin) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+// Package bootstrap builds the *mcp.Server shared by every transport
+// entrypoint (cmd/mcp-server, cmd/mcp-grpc) from a single config.Config,
+// so tool registration lives in one place.
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/syrin-labs/cli/internal/config"
+	"github.com/syrin-labs/cli/internal/foodrec"
+	"github.com/syrin-labs/cli/internal/tools"
+	"github.com/syrin-labs/cli/internal/weather"
+)
+
+// BuildRecommender constructs the foodrec.Recommender selected by
+// cfg.FoodRec.Engine.
+func BuildRecommender(cfg *config.Config) (foodrec.Recommender, error) {
+	switch cfg.FoodRec.EngineOrDefault() {
+	case "rules":
+		rules, err := foodrec.LoadRulesFile(cfg.FoodRec.RulesPathOrDefault())
+		if err != nil {
+			return nil, err
+		}
+		return foodrec.NewRulesRecommender(rules), nil
+	case "llm":
+		return foodrec.NewLLMRecommender(), nil
+	default:
+		return nil, fmt.Errorf("unknown foodrec engine %q (want \"rules\" or \"llm\")", cfg.FoodRec.Engine)
+	}
+}
+
+// BuildServer registers every tool/prompt/resource enabled by cfg and
+// returns the ready-to-run *mcp.Server. Each tool handler is wrapped in
+// tools.Instrumented so metrics and structured logs are recorded the same
+// way regardless of which transport ends up running the server.
+func BuildServer(cfg *config.Config, weatherClient *weather.Client, recommender foodrec.Recommender) *mcp.Server {
+	server := mcp.NewServer(&mcp.Implementation{Name: cfg.Server.Name, Version: cfg.Server.Version}, nil)
+
+	if cfg.Tools.IsEnabled("getCurrentLocation") {
+		mcp.AddTool(server, &mcp.Tool{
+			Name:        "getCurrentLocation",
+			Description: "Get the current location. Returns Bengaluru.",
+		}, mcp.ToolHandlerFor[tools.GetCurrentLocationArgs, any](tools.Instrumented("getCurrentLocation", tools.GetCurrentLocation())))
+	}
+	if cfg.Tools.IsEnabled("getWeather") {
+		mcp.AddTool(server, &mcp.Tool{
+			Name:        "getWeather",
+			Description: "Get live weather conditions for a location. If no location is passed, it will use the current location first.",
+		}, mcp.ToolHandlerFor[tools.LocationArgs, any](tools.Instrumented("getWeather", tools.GetWeather(weatherClient))))
+	}
+	if cfg.Tools.IsEnabled("getForecast") {
+		mcp.AddTool(server, &mcp.Tool{
+			Name:        "getForecast",
+			Description: "Get an hourly (3-hour step) weather forecast for a location, covering the requested number of days.",
+		}, mcp.ToolHandlerFor[tools.GetForecastArgs, any](tools.Instrumented("getForecast", tools.GetForecast(weatherClient))))
+	}
+	if cfg.Tools.IsEnabled("orderFood") {
+		mcp.AddTool(server, &mcp.Tool{
+			Name:        "orderFood",
+			Description: "Order food based on weather-mood. This will first check the weather, then recommend food based on the weather conditions.",
+		}, mcp.ToolHandlerFor[tools.OrderFoodArgs, any](tools.Instrumented("orderFood", tools.OrderFood(weatherClient, recommender))))
+	}
+	if rulesRec, ok := recommender.(*foodrec.RulesRecommender); ok && cfg.Tools.IsEnabled("listFoodRules") {
+		mcp.AddTool(server, &mcp.Tool{
+			Name:        "listFoodRules",
+			Description: "List the configured foodrec rules, in the order they're evaluated.",
+		}, mcp.ToolHandlerFor[tools.ListFoodRulesArgs, any](tools.Instrumented("listFoodRules", tools.ListFoodRules(rulesRec))))
+
+		server.AddResource(&mcp.Resource{
+			URI:         "foodrec://rules",
+			Name:        "Food recommendation rules",
+			Description: "The rules the orderFood tool evaluates, in order",
+			MIMEType:    "application/json",
+		}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+			body, err := json.Marshal(rulesRec.Rules())
+			if err != nil {
+				return nil, err
+			}
+			return &mcp.ReadResourceResult{
+				Contents: []*mcp.ResourceContents{
+					{URI: "foodrec://rules", MIMEType: "application/json", Text: string(body)},
+				},
+			}, nil
+		})
+	}
+
+	if cfg.Tools.IsEnabled("optimize") {
+		server.AddPrompt(&mcp.Prompt{
+			Name:        "optimize",
+			Description: "Optimize code",
+		}, func(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			code := req.Params.Arguments["code"]
+			if code == "" {
+				code = "// code here"
+			}
+			return &mcp.GetPromptResult{
+				Messages: []*mcp.PromptMessage{
+					{
+						Role:    "user",
+						Content: &mcp.TextContent{Text: fmt.Sprintf("Please optimize this code:\n\n%s", code)},
+					},
+				},
+			}, nil
+		})
+	}
+
+	if cfg.Tools.IsEnabled("example://welcome") {
+		server.AddResource(&mcp.Resource{
+			URI:         "example://welcome",
+			Name:        "Welcome",
+			Description: "Welcome message",
+			MIMEType:    "text/plain",
+		}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+			return &mcp.ReadResourceResult{
+				Contents: []*mcp.ResourceContents{
+					{
+						URI:      "example://welcome",
+						MIMEType: "text/plain",
+						Text:     "Welcome to the mcp-server!",
+					},
+				},
+			}, nil
+		})
+	}
+
+	return server
+}
@@ -0,0 +1,246 @@
+// Package config loads the layered configuration that drives cmd/mcp-server:
+// built-in defaults, overridden by config.yaml, overridden by MCP_-prefixed
+// environment variables.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the full set of knobs the server entrypoints (cmd/mcp-server,
+// cmd/mcp-grpc) need to bootstrap a server, independent of which transport
+// it ends up running over.
+type Config struct {
+	Server    ServerConfig  `yaml:"server"`
+	Transport string        `yaml:"transport"` // "stdio" or "http"; unused by cmd/mcp-grpc
+	HTTP      HTTPConfig    `yaml:"http"`
+	GRPC      GRPCConfig    `yaml:"grpc"`
+	Weather   WeatherConfig `yaml:"weather"`
+	Tools     ToolsConfig   `yaml:"tools"`
+	FoodRec   FoodRecConfig `yaml:"foodrec"`
+	LogLevel  string        `yaml:"logLevel"`
+}
+
+// ServerConfig identifies the MCP server to connecting clients.
+type ServerConfig struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+// HTTPConfig controls the Streamable HTTP transport.
+type HTTPConfig struct {
+	Addr            string   `yaml:"addr"`
+	Endpoint        string   `yaml:"endpoint"`
+	TLSCertFile     string   `yaml:"tlsCertFile"`
+	TLSKeyFile      string   `yaml:"tlsKeyFile"`
+	MaxRequestBytes int64    `yaml:"maxRequestBytes"`
+	AllowedOrigins  []string `yaml:"allowedOrigins"`
+}
+
+// TLSEnabled reports whether both halves of a TLS keypair are configured.
+func (h HTTPConfig) TLSEnabled() bool {
+	return h.TLSCertFile != "" && h.TLSKeyFile != ""
+}
+
+// GRPCConfig controls the gRPC transport (cmd/mcp-grpc).
+type GRPCConfig struct {
+	Addr        string `yaml:"addr"`
+	TLSCertFile string `yaml:"tlsCertFile"`
+	TLSKeyFile  string `yaml:"tlsKeyFile"`
+}
+
+// TLSEnabled reports whether both halves of a TLS keypair are configured.
+func (g GRPCConfig) TLSEnabled() bool {
+	return g.TLSCertFile != "" && g.TLSKeyFile != ""
+}
+
+// WeatherConfig configures the OpenWeatherMap-backed weather client.
+type WeatherConfig struct {
+	APIKey   string `yaml:"apiKey"`
+	BaseURL  string `yaml:"baseUrl"`
+	CacheTTL string `yaml:"cacheTtl"`
+}
+
+// CacheTTLDuration parses CacheTTL, returning 0 (the weather package's own
+// default) if it is unset or invalid.
+func (w WeatherConfig) CacheTTLDuration() time.Duration {
+	if w.CacheTTL == "" {
+		return 0
+	}
+	if ttl, err := time.ParseDuration(w.CacheTTL); err == nil {
+		return ttl
+	}
+	return 0
+}
+
+// FoodRecConfig selects and configures the foodrec.Recommender used by
+// the orderFood tool.
+type FoodRecConfig struct {
+	// Engine is "rules" (default) or "llm".
+	Engine    string `yaml:"engine"`
+	RulesPath string `yaml:"rulesPath"`
+}
+
+// EngineOrDefault returns Engine, defaulting to "rules" when unset.
+func (f FoodRecConfig) EngineOrDefault() string {
+	if f.Engine == "" {
+		return "rules"
+	}
+	return f.Engine
+}
+
+// RulesPathOrDefault returns RulesPath, defaulting to "food-rules.yaml"
+// when unset.
+func (f FoodRecConfig) RulesPathOrDefault() string {
+	if f.RulesPath == "" {
+		return "food-rules.yaml"
+	}
+	return f.RulesPath
+}
+
+// ToolsConfig lists which tools, prompts, and resources this server
+// instance exposes. An empty slice means "all of them" — see IsEnabled.
+type ToolsConfig struct {
+	Enabled []string `yaml:"enabled"`
+}
+
+// IsEnabled reports whether name — a tool, prompt, or resource name/URI —
+// was listed under tools.enabled, or true unconditionally if tools.enabled
+// was left empty.
+func (t ToolsConfig) IsEnabled(name string) bool {
+	if len(t.Enabled) == 0 {
+		return true
+	}
+	for _, n := range t.Enabled {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Defaults returns the built-in configuration used when config.yaml is
+// absent and no environment variables are set.
+func Defaults() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Name:    "mcp-server",
+			Version: "1.0.0",
+		},
+		Transport: "stdio",
+		HTTP: HTTPConfig{
+			Addr:            ":8000",
+			Endpoint:        "/mcp",
+			MaxRequestBytes: 1 << 20, // 1 MiB
+		},
+		GRPC: GRPCConfig{
+			Addr: ":9090",
+		},
+		Weather: WeatherConfig{},
+		Tools:   ToolsConfig{},
+		LogLevel: "info",
+	}
+}
+
+// Load builds a Config by layering config.yaml (if it exists at path) over
+// Defaults, then applying MCP_-prefixed environment variable overrides.
+func Load(path string) (*Config, error) {
+	cfg := Defaults()
+
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("config: parsing %s: %w", path, err)
+		}
+	case os.IsNotExist(err):
+		// No config.yaml is fine; defaults + env still apply.
+	default:
+		return nil, fmt.Errorf("config: reading %s: %w", path, err)
+	}
+
+	applyEnv(cfg)
+	return cfg, nil
+}
+
+// applyEnv overlays MCP_-prefixed environment variables onto cfg,
+// mirroring the envconfig convention of SECTION_FIELD naming.
+func applyEnv(cfg *Config) {
+	if v := os.Getenv("MCP_SERVER_NAME"); v != "" {
+		cfg.Server.Name = v
+	}
+	if v := os.Getenv("MCP_SERVER_VERSION"); v != "" {
+		cfg.Server.Version = v
+	}
+	if v := os.Getenv("MCP_TRANSPORT"); v != "" {
+		cfg.Transport = v
+	}
+	if v := os.Getenv("MCP_HTTP_ADDR"); v != "" {
+		cfg.HTTP.Addr = v
+	}
+	if v := os.Getenv("MCP_HTTP_ENDPOINT"); v != "" {
+		cfg.HTTP.Endpoint = v
+	}
+	if v := os.Getenv("MCP_HTTP_TLS_CERT_FILE"); v != "" {
+		cfg.HTTP.TLSCertFile = v
+	}
+	if v := os.Getenv("MCP_HTTP_TLS_KEY_FILE"); v != "" {
+		cfg.HTTP.TLSKeyFile = v
+	}
+	if v := os.Getenv("MCP_HTTP_MAX_REQUEST_BYTES"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			cfg.HTTP.MaxRequestBytes = n
+		}
+	}
+	if v := os.Getenv("MCP_HTTP_ALLOWED_ORIGINS"); v != "" {
+		cfg.HTTP.AllowedOrigins = splitCSV(v)
+	}
+	if v := os.Getenv("MCP_GRPC_ADDR"); v != "" {
+		cfg.GRPC.Addr = v
+	}
+	if v := os.Getenv("MCP_GRPC_TLS_CERT_FILE"); v != "" {
+		cfg.GRPC.TLSCertFile = v
+	}
+	if v := os.Getenv("MCP_GRPC_TLS_KEY_FILE"); v != "" {
+		cfg.GRPC.TLSKeyFile = v
+	}
+	if v := os.Getenv("MCP_OWM_APIKEY"); v != "" {
+		cfg.Weather.APIKey = v
+	}
+	if v := os.Getenv("MCP_OWM_BASE_URL"); v != "" {
+		cfg.Weather.BaseURL = v
+	}
+	if v := os.Getenv("MCP_OWM_CACHE_TTL"); v != "" {
+		cfg.Weather.CacheTTL = v
+	}
+	if v := os.Getenv("MCP_TOOLS_ENABLED"); v != "" {
+		cfg.Tools.Enabled = splitCSV(v)
+	}
+	if v := os.Getenv("MCP_FOODREC_ENGINE"); v != "" {
+		cfg.FoodRec.Engine = v
+	}
+	if v := os.Getenv("MCP_FOODREC_RULES_PATH"); v != "" {
+		cfg.FoodRec.RulesPath = v
+	}
+	if v := os.Getenv("MCP_LOG_LEVEL"); v != "" {
+		cfg.LogLevel = v
+	}
+}
+
+func splitCSV(v string) []string {
+	parts := strings.Split(v, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
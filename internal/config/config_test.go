@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadEnvOverridesYAMLOverridesDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	yamlContent := `
+server:
+  name: yaml-name
+  version: 2.0.0
+weather:
+  apiKey: yaml-key
+logLevel: debug
+`
+	if err := os.WriteFile(path, []byte(yamlContent), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("MCP_SERVER_NAME", "env-name")
+	t.Setenv("MCP_LOG_LEVEL", "")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	if cfg.Server.Name != "env-name" {
+		t.Errorf("Server.Name = %q, want env override %q", cfg.Server.Name, "env-name")
+	}
+	if cfg.Server.Version != "2.0.0" {
+		t.Errorf("Server.Version = %q, want yaml value %q", cfg.Server.Version, "2.0.0")
+	}
+	if cfg.Weather.APIKey != "yaml-key" {
+		t.Errorf("Weather.APIKey = %q, want yaml value %q", cfg.Weather.APIKey, "yaml-key")
+	}
+	if cfg.LogLevel != "debug" {
+		t.Errorf("LogLevel = %q, want yaml value %q (empty env should not override)", cfg.LogLevel, "debug")
+	}
+	// HTTP.MaxRequestBytes is untouched by the YAML above, so it should
+	// still carry the built-in default.
+	if cfg.HTTP.MaxRequestBytes != Defaults().HTTP.MaxRequestBytes {
+		t.Errorf("HTTP.MaxRequestBytes = %d, want default %d", cfg.HTTP.MaxRequestBytes, Defaults().HTTP.MaxRequestBytes)
+	}
+}
+
+func TestLoadMissingFileFallsBackToDefaults(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Server.Name != Defaults().Server.Name {
+		t.Errorf("Server.Name = %q, want default %q", cfg.Server.Name, Defaults().Server.Name)
+	}
+}
+
+func TestApplyEnvToolsEnabledCSV(t *testing.T) {
+	t.Setenv("MCP_TOOLS_ENABLED", "getWeather, orderFood")
+	cfg := Defaults()
+	applyEnv(cfg)
+
+	want := []string{"getWeather", "orderFood"}
+	if len(cfg.Tools.Enabled) != len(want) {
+		t.Fatalf("Tools.Enabled = %v, want %v", cfg.Tools.Enabled, want)
+	}
+	for i, v := range want {
+		if cfg.Tools.Enabled[i] != v {
+			t.Errorf("Tools.Enabled[%d] = %q, want %q", i, cfg.Tools.Enabled[i], v)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Package foodrec turns weather conditions into a food suggestion. It
+// replaces the if/else ladder that used to be duplicated across both MCP
+// server binaries with a Recommender interface and two implementations: a
+// YAML rules engine, and an LLM-backed recommender that uses MCP sampling.
+package foodrec
+
+import "context"
+
+// Input is what a Recommender needs to make a suggestion.
+type Input struct {
+	Condition   string
+	Temperature float64
+	Units       string
+
+	// Sampler is required by the LLM recommender and ignored by the rules
+	// engine. It's threaded through per-call because MCP sampling requires
+	// the live client session the request arrived on.
+	Sampler Sampler
+}
+
+// Recommender suggests a food/meal given current weather.
+type Recommender interface {
+	Recommend(ctx context.Context, in Input) (string, error)
+}
@@ -0,0 +1,71 @@
+package foodrec
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Sampler is the subset of *mcp.ServerSession the LLM recommender needs.
+// It's an interface purely so tests (and callers without a live session)
+// can stub it out.
+type Sampler interface {
+	CreateMessage(ctx context.Context, params *mcp.CreateMessageParams) (*mcp.CreateMessageResult, error)
+}
+
+// LLMRecommender asks the connected MCP client to run a sampling request,
+// rather than following a fixed rule list. The client is free to use
+// whatever model it has configured.
+type LLMRecommender struct {
+	MaxTokens int
+}
+
+// NewLLMRecommender returns a recommender that delegates to MCP sampling.
+func NewLLMRecommender() *LLMRecommender {
+	return &LLMRecommender{MaxTokens: 200}
+}
+
+// Recommend asks in.Sampler to suggest a meal for the given weather. It
+// requires in.Sampler to be set (wired in per-request, since sampling needs
+// the live client connection) and returns an error otherwise.
+func (r *LLMRecommender) Recommend(ctx context.Context, in Input) (string, error) {
+	if in.Sampler == nil {
+		return "", fmt.Errorf("foodrec: llm recommender requires a sampler on the request")
+	}
+
+	prompt := fmt.Sprintf(
+		"The current weather is %q at %g degrees (%s). Suggest one specific meal that fits this weather, in one sentence.",
+		in.Condition, in.Temperature, unitsOrDefault(in.Units),
+	)
+
+	result, err := in.Sampler.CreateMessage(ctx, &mcp.CreateMessageParams{
+		Messages: []*mcp.SamplingMessage{
+			{Role: "user", Content: &mcp.TextContent{Text: prompt}},
+		},
+		MaxTokens: r.maxTokens(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("foodrec: sampling request failed: %w", err)
+	}
+
+	text, ok := result.Content.(*mcp.TextContent)
+	if !ok {
+		return "", fmt.Errorf("foodrec: sampling response was not text content")
+	}
+	return text.Text, nil
+}
+
+func (r *LLMRecommender) maxTokens() int64 {
+	if r.MaxTokens <= 0 {
+		return 200
+	}
+	return int64(r.MaxTokens)
+}
+
+func unitsOrDefault(units string) string {
+	if units == "" {
+		return "standard units"
+	}
+	return units
+}
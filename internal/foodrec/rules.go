@@ -0,0 +1,115 @@
+package foodrec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Rule is one entry of the rules DSL: if When matches the current
+// weather, Suggest is returned. Rules are evaluated top-to-bottom and the
+// first match wins, so put the most specific rules first and a catch-all
+// (empty When) last.
+type Rule struct {
+	When    Condition `yaml:"when"`
+	Suggest string    `yaml:"suggest"`
+}
+
+// Condition is the "when" clause of a Rule. Both fields are optional; an
+// empty Condition matches everything, making it a catch-all.
+type Condition struct {
+	// ConditionContains matches if the weather condition (case-insensitive)
+	// contains any one of these substrings.
+	ConditionContains []string `yaml:"condition_contains"`
+	// TempRange is an inclusive [min, max] bound on temperature; either
+	// side may be omitted (null in YAML) to leave that side unbounded.
+	TempRange []*float64 `yaml:"temp_range"`
+}
+
+// matches reports whether the condition's constraints (if any) all hold.
+// A Condition with no constraints at all is a catch-all and always matches.
+func (c Condition) matches(conditionLower string, temp float64) bool {
+	if len(c.ConditionContains) > 0 {
+		matched := false
+		for _, want := range c.ConditionContains {
+			if strings.Contains(conditionLower, strings.ToLower(want)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if min, max := c.tempBounds(); min != nil || max != nil {
+		if min != nil && temp < *min {
+			return false
+		}
+		if max != nil && temp > *max {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c Condition) tempBounds() (min, max *float64) {
+	if len(c.TempRange) > 0 {
+		min = c.TempRange[0]
+	}
+	if len(c.TempRange) > 1 {
+		max = c.TempRange[1]
+	}
+	return min, max
+}
+
+// RulesRecommender evaluates a fixed, ordered list of Rules.
+type RulesRecommender struct {
+	rules []Rule
+}
+
+// NewRulesRecommender wraps an already-loaded rule list.
+func NewRulesRecommender(rules []Rule) *RulesRecommender {
+	return &RulesRecommender{rules: rules}
+}
+
+// LoadRulesFile reads and parses a YAML rules file in the format:
+//
+//	- when:
+//	    condition_contains: [rain, cloudy]
+//	    temp_range: [null, 25]
+//	  suggest: "..."
+func LoadRulesFile(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("foodrec: reading %s: %w", path, err)
+	}
+	var rules []Rule
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("foodrec: parsing %s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// Rules returns the configured rule list, in evaluation order. Used by the
+// listFoodRules tool and the foodrec://rules resource to let clients
+// introspect what's configured.
+func (r *RulesRecommender) Rules() []Rule {
+	return r.rules
+}
+
+// Recommend returns the Suggest field of the first matching rule. If no
+// rule matches, it returns an error rather than guessing.
+func (r *RulesRecommender) Recommend(ctx context.Context, in Input) (string, error) {
+	conditionLower := strings.ToLower(in.Condition)
+	for _, rule := range r.rules {
+		if rule.When.matches(conditionLower, in.Temperature) {
+			return rule.Suggest, nil
+		}
+	}
+	return "", fmt.Errorf("foodrec: no rule matched condition %q at %g degrees", in.Condition, in.Temperature)
+}
@@ -0,0 +1,70 @@
+package foodrec
+
+import (
+	"context"
+	"testing"
+)
+
+func ptr(f float64) *float64 { return &f }
+
+func TestConditionMatches(t *testing.T) {
+	cases := []struct {
+		name string
+		cond Condition
+		temp float64
+		cur  string
+		want bool
+	}{
+		{"catch-all", Condition{}, 15, "clear", true},
+		{"condition contains match", Condition{ConditionContains: []string{"rain", "cloudy"}}, 20, "light rain", true},
+		{"condition contains no match", Condition{ConditionContains: []string{"snow"}}, 20, "clear", false},
+		{"lower bound inclusive", Condition{TempRange: []*float64{ptr(30), nil}}, 30, "clear", true},
+		{"below lower bound", Condition{TempRange: []*float64{ptr(30), nil}}, 29.9, "clear", false},
+		{"upper bound inclusive", Condition{TempRange: []*float64{nil, ptr(20)}}, 20, "clear", true},
+		{"above upper bound", Condition{TempRange: []*float64{nil, ptr(20)}}, 20.1, "clear", false},
+		{"both conditions must hold", Condition{ConditionContains: []string{"rain"}, TempRange: []*float64{nil, ptr(20)}}, 25, "rain", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.cond.matches(tc.cur, tc.temp); got != tc.want {
+				t.Errorf("matches(%q, %g) = %v, want %v", tc.cur, tc.temp, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRulesRecommenderRecommend(t *testing.T) {
+	rules := []Rule{
+		{When: Condition{TempRange: []*float64{ptr(30), nil}}, Suggest: "ice cream"},
+		{When: Condition{TempRange: []*float64{nil, ptr(20)}}, Suggest: "soup"},
+		{When: Condition{}, Suggest: "sandwich"},
+	}
+	rec := NewRulesRecommender(rules)
+
+	cases := []struct {
+		temp float64
+		want string
+	}{
+		{35, "ice cream"},
+		{15, "soup"},
+		{25, "sandwich"},
+	}
+	for _, tc := range cases {
+		got, err := rec.Recommend(context.Background(), Input{Condition: "clear", Temperature: tc.temp})
+		if err != nil {
+			t.Fatalf("Recommend(%g) error: %v", tc.temp, err)
+		}
+		if got != tc.want {
+			t.Errorf("Recommend(%g) = %q, want %q", tc.temp, got, tc.want)
+		}
+	}
+}
+
+func TestRulesRecommenderNoMatch(t *testing.T) {
+	rec := NewRulesRecommender([]Rule{
+		{When: Condition{TempRange: []*float64{ptr(30), nil}}, Suggest: "ice cream"},
+	})
+	if _, err := rec.Recommend(context.Background(), Input{Condition: "clear", Temperature: 15}); err == nil {
+		t.Fatal("Recommend() with no matching rule = nil error, want error")
+	}
+}
@@ -0,0 +1,194 @@
+// Package grpcserver adapts the gRPC service defined in proto/mcp.proto
+// onto an existing *mcp.Server, so tool implementations are written once
+// in internal/tools and served over stdio, Streamable HTTP, or gRPC.
+//
+// The go-sdk only exposes tool/prompt/resource invocation through a
+// *mcp.ClientSession, so New connects one to the server in-process over
+// mcp.NewInMemoryTransports and routes every RPC through it.
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mcpv1 "github.com/syrin-labs/cli/proto/mcpv1"
+)
+
+// Server implements mcpv1.McpServiceServer on top of an in-process
+// *mcp.ClientSession, translating between the protobuf wire types and the
+// mcp.CallToolParams/mcp.CallToolResult types tool handlers already use.
+type Server struct {
+	mcpv1.UnimplementedMcpServiceServer
+
+	session *mcp.ClientSession
+}
+
+// New connects an in-process client session to server over an in-memory
+// transport and wraps it for serving over gRPC. ctx governs the lifetime
+// of that connection: canceling it (e.g. on process shutdown) closes the
+// session.
+func New(ctx context.Context, server *mcp.Server) (*Server, error) {
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	go func() {
+		_ = server.Run(ctx, serverTransport)
+	}()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "mcp-grpc", Version: "1.0.0"}, nil)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		return nil, fmt.Errorf("grpcserver: connecting in-process client session: %w", err)
+	}
+	return &Server{session: session}, nil
+}
+
+// CallTool invokes a tool by name and waits for its final result.
+func (s *Server) CallTool(ctx context.Context, req *mcpv1.CallToolRequest) (*mcpv1.CallToolResult, error) {
+	result, err := s.session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      req.GetName(),
+		Arguments: json.RawMessage(req.GetArgumentsJson()),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if result.IsError {
+		return nil, toolErrorStatus(result)
+	}
+	return toProtoResult(result), nil
+}
+
+// StreamTool invokes a tool and streams its result. None of the tools in
+// internal/tools produce intermediate progress today, so this sends a
+// single final ToolProgress message; it exists so clients can adopt the
+// streaming RPC ahead of any tool actually using it.
+func (s *Server) StreamTool(req *mcpv1.CallToolRequest, stream mcpv1.McpService_StreamToolServer) error {
+	result, err := s.session.CallTool(stream.Context(), &mcp.CallToolParams{
+		Name:      req.GetName(),
+		Arguments: json.RawMessage(req.GetArgumentsJson()),
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if result.IsError {
+		return toolErrorStatus(result)
+	}
+	return stream.Send(&mcpv1.ToolProgress{Done: true, Final: toProtoResult(result)})
+}
+
+// ListTools returns every tool registered on the underlying server.
+func (s *Server) ListTools(ctx context.Context, req *mcpv1.ListToolsRequest) (*mcpv1.ListToolsResult, error) {
+	result, err := s.session.ListTools(ctx, &mcp.ListToolsParams{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := make([]*mcpv1.ToolDescriptor, 0, len(result.Tools))
+	for _, t := range result.Tools {
+		schema, err := json.Marshal(t.InputSchema)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		out = append(out, &mcpv1.ToolDescriptor{
+			Name:            t.Name,
+			Description:     t.Description,
+			InputSchemaJson: string(schema),
+		})
+	}
+	return &mcpv1.ListToolsResult{Tools: out}, nil
+}
+
+// GetPrompt renders a registered prompt with the given arguments.
+func (s *Server) GetPrompt(ctx context.Context, req *mcpv1.GetPromptRequest) (*mcpv1.GetPromptResult, error) {
+	result, err := s.session.GetPrompt(ctx, &mcp.GetPromptParams{
+		Name:      req.GetName(),
+		Arguments: req.GetArguments(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	messages := make([]*mcpv1.PromptMessage, 0, len(result.Messages))
+	for _, m := range result.Messages {
+		text, ok := m.Content.(*mcp.TextContent)
+		if !ok {
+			continue
+		}
+		messages = append(messages, &mcpv1.PromptMessage{
+			Role:    string(m.Role),
+			Content: &mcpv1.Content{Content: &mcpv1.Content_Text{Text: text.Text}},
+		})
+	}
+	return &mcpv1.GetPromptResult{Messages: messages}, nil
+}
+
+// ReadResource reads a registered resource by URI.
+func (s *Server) ReadResource(ctx context.Context, req *mcpv1.ReadResourceRequest) (*mcpv1.ReadResourceResult, error) {
+	result, err := s.session.ReadResource(ctx, &mcp.ReadResourceParams{URI: req.GetUri()})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	contents := make([]*mcpv1.ResourceContents, 0, len(result.Contents))
+	for _, c := range result.Contents {
+		contents = append(contents, &mcpv1.ResourceContents{
+			Uri:      c.URI,
+			MimeType: c.MIMEType,
+			Text:     c.Text,
+		})
+	}
+	return &mcpv1.ReadResourceResult{Contents: contents}, nil
+}
+
+func toProtoResult(result *mcp.CallToolResult) *mcpv1.CallToolResult {
+	content := make([]*mcpv1.Content, 0, len(result.Content))
+	for _, c := range result.Content {
+		if text, ok := c.(*mcp.TextContent); ok {
+			content = append(content, &mcpv1.Content{Content: &mcpv1.Content_Text{Text: text.Text}})
+		}
+	}
+	return &mcpv1.CallToolResult{Content: content, IsError: result.IsError}
+}
+
+// toolBody is the {"error": "...", "code": "..."} shape weatherErrorResult
+// (and similarly-shaped tool errors) write into an IsError result's text
+// content.
+type toolBody struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// toolErrorStatus maps a tool's IsError result onto a gRPC status, using
+// the typed error code tools embed in their JSON body when present:
+// NotFound for unknown locations, Unavailable for upstream API failures,
+// InvalidArgument for bad caller input, and Internal for anything else
+// (including a missing code, which means the tool failed for reasons that
+// aren't the caller's fault).
+func toolErrorStatus(result *mcp.CallToolResult) error {
+	var body toolBody
+	for _, c := range result.Content {
+		if text, ok := c.(*mcp.TextContent); ok {
+			if err := json.Unmarshal([]byte(text.Text), &body); err == nil {
+				break
+			}
+		}
+	}
+
+	switch body.Code {
+	case "not-found":
+		return status.Error(codes.NotFound, body.Error)
+	case "bad-request":
+		return status.Error(codes.InvalidArgument, body.Error)
+	case "auth", "rate-limit", "upstream":
+		return status.Error(codes.Unavailable, body.Error)
+	default:
+		// No typed code (or one we don't recognize) means the failure
+		// wasn't the caller's fault — e.g. orderFood's "no rule matched"
+		// is a food-rules.yaml configuration gap, not a bad argument.
+		return status.Error(codes.Internal, body.Error)
+	}
+}
@@ -0,0 +1,35 @@
+// Package metrics holds the Prometheus collectors shared by every tool
+// handler, so per-tool call counts, error counts, and latency are
+// recorded uniformly regardless of which transport served the call.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	toolCallsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mcp_tool_calls_total",
+		Help: "Total number of MCP tool calls, labeled by tool name and outcome.",
+	}, []string{"tool", "status"})
+
+	toolCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mcp_tool_call_duration_seconds",
+		Help:    "MCP tool call latency in seconds, labeled by tool name.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"tool"})
+)
+
+// ObserveToolCall records one completed call to tool, with its outcome and
+// duration.
+func ObserveToolCall(tool string, duration time.Duration, isError bool) {
+	status := "ok"
+	if isError {
+		status = "error"
+	}
+	toolCallsTotal.WithLabelValues(tool, status).Inc()
+	toolCallDuration.WithLabelValues(tool).Observe(duration.Seconds())
+}
@@ -0,0 +1,175 @@
+// Package tools holds the named MCP tool handlers shared by every
+// transport (stdio, HTTP, and — see cmd/mcp-grpc — gRPC), so registration
+// is a one-liner and Instrumented can wrap them uniformly.
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/syrin-labs/cli/internal/foodrec"
+	"github.com/syrin-labs/cli/internal/weather"
+)
+
+// LocationArgs is the oneof location selector shared by getWeather and
+// getForecast: exactly one of Location, Zip, or (Lat, Lon) is expected.
+type LocationArgs struct {
+	Location string   `json:"location" jsonschema:"Location name (optional, defaults to current location)"`
+	Zip      string   `json:"zip" jsonschema:"Zip/postal code, e.g. '560001,in' (optional)"`
+	Lat      *float64 `json:"lat" jsonschema:"Latitude, used together with lon (optional)"`
+	Lon      *float64 `json:"lon" jsonschema:"Longitude, used together with lat (optional)"`
+	Units    string   `json:"units" jsonschema:"metric, imperial, or standard (optional, defaults to standard)"`
+	Lang     string   `json:"lang" jsonschema:"Response language code, e.g. 'en' (optional)"`
+}
+
+func (a LocationArgs) toLocation() weather.Location {
+	if a.Lat != nil && a.Lon != nil {
+		return weather.Location{Lat: a.Lat, Lon: a.Lon}
+	}
+	if a.Zip != "" {
+		return weather.Location{Zip: a.Zip}
+	}
+	location := a.Location
+	if location == "" {
+		location = "Bengaluru"
+	}
+	return weather.Location{Name: location}
+}
+
+// location implements locationGetter so Instrumented can log the
+// caller-supplied location without knowing about weather.Location.
+func (a LocationArgs) location() string {
+	switch {
+	case a.Lat != nil && a.Lon != nil:
+		return fmt.Sprintf("%g,%g", *a.Lat, *a.Lon)
+	case a.Zip != "":
+		return a.Zip
+	default:
+		return a.Location
+	}
+}
+
+// GetCurrentLocationArgs takes no input.
+type GetCurrentLocationArgs struct{}
+
+// GetCurrentLocation returns the server's notion of "here". In this
+// example deployment that's always Bengaluru.
+func GetCurrentLocation() HandlerFunc[GetCurrentLocationArgs] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args GetCurrentLocationArgs) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: `{"location": "Bengaluru", "message": "Current location retrieved successfully."}`},
+			},
+		}, nil, nil
+	}
+}
+
+// GetWeather returns live current conditions for the requested location.
+func GetWeather(client *weather.Client) HandlerFunc[LocationArgs] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args LocationArgs) (*mcp.CallToolResult, any, error) {
+		current, err := client.Current(ctx, weather.Query{Location: args.toLocation(), Units: args.Units, Lang: args.Lang})
+		if err != nil {
+			return weatherErrorResult(err), nil, nil
+		}
+		body, err := json.Marshal(current)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(body)}}}, nil, nil
+	}
+}
+
+// GetForecastArgs extends LocationArgs with the number of days to forecast.
+type GetForecastArgs struct {
+	LocationArgs
+	Days int `json:"days" jsonschema:"Number of days to forecast, 1-5 (optional, defaults to 1)"`
+}
+
+// GetForecast returns an hourly (3-hour step) forecast for the requested
+// location and number of days.
+func GetForecast(client *weather.Client) HandlerFunc[GetForecastArgs] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args GetForecastArgs) (*mcp.CallToolResult, any, error) {
+		forecast, err := client.Forecast(ctx, weather.Query{Location: args.toLocation(), Units: args.Units, Lang: args.Lang}, args.Days)
+		if err != nil {
+			return weatherErrorResult(err), nil, nil
+		}
+		body, err := json.Marshal(forecast)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(body)}}}, nil, nil
+	}
+}
+
+// OrderFoodArgs takes the same plain location name the original example
+// tool did.
+type OrderFoodArgs struct {
+	Location string `json:"location" jsonschema:"Location name (optional, defaults to current location)"`
+}
+
+func (a OrderFoodArgs) location() string { return a.Location }
+
+// OrderFood checks the weather, then asks rec to recommend food based on
+// conditions. It always fetches in metric units, since food-rules.yaml's
+// temp_range thresholds are Celsius. For an LLM-backed rec, the sampling
+// request rides on the same client session the tool call arrived on.
+func OrderFood(client *weather.Client, rec foodrec.Recommender) HandlerFunc[OrderFoodArgs] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args OrderFoodArgs) (*mcp.CallToolResult, any, error) {
+		loc := LocationArgs{Location: args.Location}.toLocation()
+		current, err := client.Current(ctx, weather.Query{Location: loc, Units: "metric"})
+		if err != nil {
+			return weatherErrorResult(err), nil, nil
+		}
+
+		recommendation, err := rec.Recommend(ctx, foodrec.Input{
+			Condition:   current.Condition,
+			Temperature: current.Temp,
+			Units:       current.Units,
+			Sampler:     req.Session,
+		})
+		if err != nil {
+			body, _ := json.Marshal(map[string]any{"error": err.Error(), "code": "foodrec-unmatched"})
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(body)}}, IsError: true}, nil, nil
+		}
+
+		result := fmt.Sprintf(`{"location": "%s", "weather": {"temperature": %g, "condition": "%s"}, "order": "%s", "status": "Ordered", "message": "Food ordered based on weather in %s."}`,
+			current.Location, current.Temp, current.Condition, recommendation, current.Location)
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: result}}}, nil, nil
+	}
+}
+
+// ListFoodRulesArgs takes no input.
+type ListFoodRulesArgs struct{}
+
+// ListFoodRules exposes the configured rules engine's rule list, so
+// clients can introspect what orderFood will do without guessing from
+// the weather alone. Only meaningful when foodrec is configured with the
+// rules engine.
+func ListFoodRules(rec *foodrec.RulesRecommender) HandlerFunc[ListFoodRulesArgs] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args ListFoodRulesArgs) (*mcp.CallToolResult, any, error) {
+		body, err := json.Marshal(rec.Rules())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(body)}}}, nil, nil
+	}
+}
+
+// weatherErrorResult renders a weather.Error as an MCP IsError result,
+// preserving the typed error code so clients can branch on it without
+// parsing the message text.
+func weatherErrorResult(err error) *mcp.CallToolResult {
+	code := weather.ErrUpstream
+	message := err.Error()
+	if werr, ok := err.(*weather.Error); ok {
+		code = werr.Code
+		message = werr.Message
+	}
+	body, _ := json.Marshal(map[string]any{"error": message, "code": code})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: string(body)}},
+		IsError: true,
+	}
+}
@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/syrin-labs/cli/internal/metrics"
+)
+
+// HandlerFunc is the shape every mcp.AddTool handler has: a typed args
+// struct in, a CallToolResult (plus optional structured output) out.
+type HandlerFunc[T any] func(ctx context.Context, req *mcp.CallToolRequest, args T) (*mcp.CallToolResult, any, error)
+
+// logger writes Instrumented's per-call log lines as JSON to stderr, which
+// keeps them off stdout when the server runs over the stdio transport.
+var logger = slog.New(slog.NewJSONHandler(os.Stderr, nil))
+
+// Instrumented wraps fn so every call is recorded as a Prometheus metric
+// (mcp_tool_calls_total, mcp_tool_call_duration_seconds) and emitted as a
+// structured JSON log line, uniformly across tools and across transports.
+func Instrumented[T any](name string, fn HandlerFunc[T]) HandlerFunc[T] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args T) (*mcp.CallToolResult, any, error) {
+		requestID := newRequestID()
+		start := time.Now()
+
+		result, out, err := fn(ctx, req, args)
+
+		duration := time.Since(start)
+		isError := err != nil || (result != nil && result.IsError)
+		metrics.ObserveToolCall(name, duration, isError)
+
+		attrs := []any{
+			"request_id", requestID,
+			"tool", name,
+			"duration_ms", duration.Milliseconds(),
+			"error", isError,
+		}
+		if loc, ok := any(args).(locationGetter); ok {
+			attrs = append(attrs, "location", loc.location())
+		}
+		if err != nil {
+			attrs = append(attrs, "err", err.Error())
+			logger.Error("mcp tool call failed", attrs...)
+		} else {
+			logger.Info("mcp tool call", attrs...)
+		}
+
+		return result, out, err
+	}
+}
+
+// locationGetter is implemented by tool args that carry a caller-supplied
+// location, purely so Instrumented can log it without depending on the
+// concrete args type.
+type locationGetter interface {
+	location() string
+}
+
+func newRequestID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b[:])
+}
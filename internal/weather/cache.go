@@ -0,0 +1,50 @@
+package weather
+
+import (
+	"sync"
+	"time"
+)
+
+// cache is a small in-process TTL cache keyed by a normalized
+// location+units+lang string. It exists to avoid hammering the upstream
+// API when the same location is requested repeatedly in a short window.
+type cache struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	now func() time.Time
+
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *cache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || c.now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *cache) set(key string, value any) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cacheEntry{value: value, expires: c.now().Add(c.ttl)}
+}
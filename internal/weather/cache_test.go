@@ -0,0 +1,36 @@
+package weather
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCacheExpiry(t *testing.T) {
+	now := time.Now()
+	c := newCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.set("key", "value")
+
+	if _, ok := c.get("missing"); ok {
+		t.Fatal("get(missing) = ok, want not found")
+	}
+
+	got, ok := c.get("key")
+	if !ok || got != "value" {
+		t.Fatalf("get(key) = %v, %v, want value, true", got, ok)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.get("key"); ok {
+		t.Fatal("get(key) after ttl = ok, want expired")
+	}
+}
+
+func TestCacheZeroTTLNeverStores(t *testing.T) {
+	c := newCache(0)
+	c.set("key", "value")
+	if _, ok := c.get("key"); ok {
+		t.Fatal("get(key) = ok, want zero-TTL cache to never store")
+	}
+}
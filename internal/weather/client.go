@@ -0,0 +1,233 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const defaultBaseURL = "https://api.openweathermap.org/data/2.5"
+
+// Client fetches current conditions and forecasts from OpenWeatherMap,
+// caching responses in-process to keep repeated lookups for the same
+// location+units+lang cheap.
+type Client struct {
+	APIKey  string
+	BaseURL string
+
+	httpClient *http.Client
+	cache      *cache
+}
+
+// Config controls how a Client is constructed. Zero values fall back to
+// sensible defaults (the live OpenWeatherMap API, a 10 minute cache TTL).
+type Config struct {
+	APIKey   string
+	BaseURL  string
+	CacheTTL time.Duration
+	Timeout  time.Duration
+}
+
+// NewClient builds a Client from cfg. APIKey is required; everything else
+// has a default.
+func NewClient(cfg Config) *Client {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	ttl := cfg.CacheTTL
+	if ttl == 0 {
+		ttl = 10 * time.Minute
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	return &Client{
+		APIKey:     cfg.APIKey,
+		BaseURL:    baseURL,
+		httpClient: &http.Client{Timeout: timeout},
+		cache:      newCache(ttl),
+	}
+}
+
+// Query describes a current-conditions or forecast request.
+type Query struct {
+	Location Location
+	Units    string // metric, imperial, or standard
+	Lang     string
+}
+
+func (q Query) normalizedKey(kind string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", kind, q.Location.normalized(), q.Units, q.Lang)
+}
+
+// Current returns the current conditions for q.Location, serving from the
+// in-process cache when a fresh-enough entry exists.
+func (c *Client) Current(ctx context.Context, q Query) (*Current, error) {
+	key := q.normalizedKey("current")
+	if cached, ok := c.cache.get(key); ok {
+		result := cached.(Current)
+		return &result, nil
+	}
+
+	params, err := q.Location.queryParams()
+	if err != nil {
+		return nil, err
+	}
+	params.Set("appid", c.APIKey)
+	if q.Units != "" {
+		params.Set("units", q.Units)
+	}
+	if q.Lang != "" {
+		params.Set("lang", q.Lang)
+	}
+
+	var resp owmWeatherResponse
+	if err := c.get(ctx, "/weather", params, &resp); err != nil {
+		return nil, err
+	}
+
+	condition := ""
+	if len(resp.Weather) > 0 {
+		condition = resp.Weather[0].Main
+	}
+	current := Current{
+		Location:   resp.Name,
+		Lat:        resp.Coord.Lat,
+		Lon:        resp.Coord.Lon,
+		Temp:       resp.Main.Temp,
+		FeelsLike:  resp.Main.FeelsLike,
+		Condition:  condition,
+		Humidity:   resp.Main.Humidity,
+		Pressure:   resp.Main.Pressure,
+		Visibility: resp.Visibility,
+		Clouds:     resp.Clouds.All,
+		WindSpeed:  resp.Wind.Speed,
+		Sunrise:    resp.Sys.Sunrise,
+		Sunset:     resp.Sys.Sunset,
+		Units:      unitsOrDefault(q.Units),
+	}
+
+	c.cache.set(key, current)
+	return &current, nil
+}
+
+// Forecast returns hourly (3-hour step) forecast entries covering the
+// requested number of days for q.Location.
+func (c *Client) Forecast(ctx context.Context, q Query, days int) (*Forecast, error) {
+	if days <= 0 {
+		days = 1
+	}
+	key := fmt.Sprintf("%s:%d", q.normalizedKey("forecast"), days)
+	if cached, ok := c.cache.get(key); ok {
+		result := cached.(Forecast)
+		return &result, nil
+	}
+
+	params, err := q.Location.queryParams()
+	if err != nil {
+		return nil, err
+	}
+	params.Set("appid", c.APIKey)
+	if q.Units != "" {
+		params.Set("units", q.Units)
+	}
+	if q.Lang != "" {
+		params.Set("lang", q.Lang)
+	}
+	// OpenWeatherMap's forecast endpoint returns 3-hour steps, 8 per day.
+	params.Set("cnt", fmt.Sprintf("%d", days*8))
+
+	var resp owmForecastResponse
+	if err := c.get(ctx, "/forecast", params, &resp); err != nil {
+		return nil, err
+	}
+
+	entries := make([]ForecastEntry, 0, len(resp.List))
+	for _, item := range resp.List {
+		condition := ""
+		if len(item.Weather) > 0 {
+			condition = item.Weather[0].Main
+		}
+		entries = append(entries, ForecastEntry{
+			Time:      item.Dt,
+			Temp:      item.Main.Temp,
+			FeelsLike: item.Main.FeelsLike,
+			Condition: condition,
+			Humidity:  item.Main.Humidity,
+			Pressure:  item.Main.Pressure,
+			Clouds:    item.Clouds.All,
+			WindSpeed: item.Wind.Speed,
+		})
+	}
+
+	forecast := Forecast{
+		Location: resp.City.Name,
+		Units:    unitsOrDefault(q.Units),
+		Entries:  entries,
+	}
+
+	c.cache.set(key, forecast)
+	return &forecast, nil
+}
+
+// get issues a GET request against path with params and decodes the JSON
+// body into out, translating non-2xx responses into a typed *Error.
+func (c *Client) get(ctx context.Context, path string, params url.Values, out any) error {
+	u := c.BaseURL + path + "?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return &Error{Code: ErrUpstream, Message: err.Error()}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &Error{Code: ErrUpstream, Message: err.Error()}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return &Error{
+			Code:    codeForStatus(resp.StatusCode),
+			Status:  resp.StatusCode,
+			Message: string(body),
+		}
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return &Error{Code: ErrUpstream, Message: fmt.Sprintf("decoding response: %v", err)}
+	}
+	return nil
+}
+
+// Ping checks that the upstream weather API is reachable, for use by
+// readiness probes. It does not require a valid API key to succeed.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return &Error{Code: ErrUpstream, Message: err.Error()}
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func unitsOrDefault(units string) string {
+	if units == "" {
+		return "standard"
+	}
+	return units
+}
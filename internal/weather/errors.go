@@ -0,0 +1,44 @@
+package weather
+
+import "fmt"
+
+// ErrorCode classifies a failure from the upstream weather API so callers
+// (MCP tool handlers in particular) can map it to an appropriate result
+// without string-matching error messages.
+type ErrorCode string
+
+const (
+	ErrAuth       ErrorCode = "auth"
+	ErrRateLimit  ErrorCode = "rate-limit"
+	ErrNotFound   ErrorCode = "not-found"
+	ErrBadRequest ErrorCode = "bad-request"
+	ErrUpstream   ErrorCode = "upstream"
+)
+
+// Error is returned by Client methods whenever the OpenWeatherMap API
+// responds with something other than a usable payload.
+type Error struct {
+	Code    ErrorCode
+	Status  int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("weather: %s (status %d): %s", e.Code, e.Status, e.Message)
+}
+
+// codeForStatus maps an OpenWeatherMap HTTP status to an ErrorCode.
+func codeForStatus(status int) ErrorCode {
+	switch status {
+	case 400:
+		return ErrBadRequest
+	case 401:
+		return ErrAuth
+	case 404:
+		return ErrNotFound
+	case 429:
+		return ErrRateLimit
+	default:
+		return ErrUpstream
+	}
+}
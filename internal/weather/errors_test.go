@@ -0,0 +1,22 @@
+package weather
+
+import "testing"
+
+func TestCodeForStatus(t *testing.T) {
+	cases := []struct {
+		status int
+		want   ErrorCode
+	}{
+		{400, ErrBadRequest},
+		{401, ErrAuth},
+		{404, ErrNotFound},
+		{429, ErrRateLimit},
+		{500, ErrUpstream},
+		{200, ErrUpstream},
+	}
+	for _, tc := range cases {
+		if got := codeForStatus(tc.status); got != tc.want {
+			t.Errorf("codeForStatus(%d) = %q, want %q", tc.status, got, tc.want)
+		}
+	}
+}
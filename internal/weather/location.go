@@ -0,0 +1,49 @@
+package weather
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Location is a oneof selector for the three ways OpenWeatherMap lets
+// callers identify a place: a free-text name, a zip/postal code, or a
+// lat/lon pair. Exactly one of Name, Zip, or (Lat, Lon) should be set.
+type Location struct {
+	Name string
+	Zip  string
+	Lat  *float64
+	Lon  *float64
+}
+
+// normalized returns a stable cache key for this location, independent of
+// how the caller formatted it.
+func (l Location) normalized() string {
+	switch {
+	case l.Lat != nil && l.Lon != nil:
+		return fmt.Sprintf("geo:%.4f,%.4f", *l.Lat, *l.Lon)
+	case l.Zip != "":
+		return "zip:" + strings.ToLower(strings.TrimSpace(l.Zip))
+	default:
+		return "name:" + strings.ToLower(strings.TrimSpace(l.Name))
+	}
+}
+
+// queryParams renders the location as the query parameters OpenWeatherMap
+// expects on its /weather and /forecast endpoints.
+func (l Location) queryParams() (url.Values, error) {
+	q := url.Values{}
+	switch {
+	case l.Lat != nil && l.Lon != nil:
+		q.Set("lat", strconv.FormatFloat(*l.Lat, 'f', -1, 64))
+		q.Set("lon", strconv.FormatFloat(*l.Lon, 'f', -1, 64))
+	case l.Zip != "":
+		q.Set("zip", l.Zip)
+	case l.Name != "":
+		q.Set("q", l.Name)
+	default:
+		return nil, &Error{Code: ErrBadRequest, Message: "one of location, zip, or lat/lon is required"}
+	}
+	return q, nil
+}
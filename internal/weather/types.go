@@ -0,0 +1,99 @@
+package weather
+
+// Current is the normalized current-conditions response returned by
+// Client.Current, trimmed down from OpenWeatherMap's /weather payload to
+// the fields the MCP tools surface.
+type Current struct {
+	Location   string  `json:"location"`
+	Lat        float64 `json:"lat"`
+	Lon        float64 `json:"lon"`
+	Temp       float64 `json:"temperature"`
+	FeelsLike  float64 `json:"feels_like"`
+	Condition  string  `json:"condition"`
+	Humidity   int     `json:"humidity"`
+	Pressure   int     `json:"pressure"`
+	Visibility int     `json:"visibility"`
+	Clouds     int     `json:"clouds"`
+	WindSpeed  float64 `json:"windSpeed"`
+	Sunrise    int64   `json:"sunrise"`
+	Sunset     int64   `json:"sunset"`
+	Units      string  `json:"units"`
+}
+
+// Forecast is the normalized response returned by Client.Forecast: a
+// sequence of 3-hour entries covering the requested number of days.
+type Forecast struct {
+	Location string          `json:"location"`
+	Units    string          `json:"units"`
+	Entries  []ForecastEntry `json:"entries"`
+}
+
+// ForecastEntry is a single hourly (3-hour step) forecast point.
+type ForecastEntry struct {
+	Time      int64   `json:"time"`
+	Temp      float64 `json:"temperature"`
+	FeelsLike float64 `json:"feels_like"`
+	Condition string  `json:"condition"`
+	Humidity  int     `json:"humidity"`
+	Pressure  int     `json:"pressure"`
+	Clouds    int     `json:"clouds"`
+	WindSpeed float64 `json:"windSpeed"`
+}
+
+// owmWeatherResponse mirrors the subset of OpenWeatherMap's /data/2.5/weather
+// payload this package consumes.
+type owmWeatherResponse struct {
+	Coord struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	} `json:"coord"`
+	Weather []struct {
+		Main string `json:"main"`
+	} `json:"weather"`
+	Main struct {
+		Temp      float64 `json:"temp"`
+		FeelsLike float64 `json:"feels_like"`
+		Pressure  int     `json:"pressure"`
+		Humidity  int     `json:"humidity"`
+	} `json:"main"`
+	Visibility int `json:"visibility"`
+	Wind       struct {
+		Speed float64 `json:"speed"`
+	} `json:"wind"`
+	Clouds struct {
+		All int `json:"all"`
+	} `json:"clouds"`
+	Sys struct {
+		Sunrise int64 `json:"sunrise"`
+		Sunset  int64 `json:"sunset"`
+	} `json:"sys"`
+	Name string `json:"name"`
+	Cod  int    `json:"cod"`
+}
+
+// owmForecastResponse mirrors the subset of OpenWeatherMap's
+// /data/2.5/forecast payload this package consumes.
+type owmForecastResponse struct {
+	Cod  string `json:"cod"`
+	City struct {
+		Name string `json:"name"`
+	} `json:"city"`
+	List []struct {
+		Dt   int64 `json:"dt"`
+		Main struct {
+			Temp      float64 `json:"temp"`
+			FeelsLike float64 `json:"feels_like"`
+			Pressure  int     `json:"pressure"`
+			Humidity  int     `json:"humidity"`
+		} `json:"main"`
+		Weather []struct {
+			Main string `json:"main"`
+		} `json:"weather"`
+		Clouds struct {
+			All int `json:"all"`
+		} `json:"clouds"`
+		Wind struct {
+			Speed float64 `json:"speed"`
+		} `json:"wind"`
+	} `json:"list"`
+}